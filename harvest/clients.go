@@ -0,0 +1,150 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type ClientsService service
+
+// BillingClient is a Harvest client (a company billed for work), named to
+// avoid colliding with this package's own Client type.
+type BillingClient struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	IsActive  bool   `json:"is_active"`
+	Address   string `json:"address"`
+	Currency  string `json:"currency"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ClientsListOptions specifies the optional parameters to the
+// ClientsService.List method.
+type ClientsListOptions struct {
+	IsActive     bool   `url:"is_active,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+
+	ListOptions
+}
+
+// ClientCreateRequest is the request body for ClientsService.Create.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#create-a-client
+type ClientCreateRequest struct {
+	Name     string  `json:"name"`
+	IsActive *bool   `json:"is_active,omitempty"`
+	Address  *string `json:"address,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+}
+
+// ClientUpdateRequest is the request body for ClientsService.Update. Only
+// non-nil fields are sent to the API.
+type ClientUpdateRequest struct {
+	Name     *string `json:"name,omitempty"`
+	IsActive *bool   `json:"is_active,omitempty"`
+	Address  *string `json:"address,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+}
+
+// List lists clients. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#list-all-clients
+func (s *ClientsService) List(ctx context.Context, opts *ClientsListOptions, callOpts ...CallOption) ([]*BillingClient, *Response, error) {
+	u := "clients"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Clients []*BillingClient `json:"clients"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Clients, resp, nil
+}
+
+// Get retrieves a client. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#retrieve-a-client
+func (s *ClientsService) Get(ctx context.Context, clientId int, callOpts ...CallOption) (*BillingClient, *Response, error) {
+	u := fmt.Sprintf("clients/%d", clientId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var client BillingClient
+	resp, err := s.client.Do(ctx, req, &client, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &client, resp, nil
+}
+
+// Create creates a new client. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#create-a-client
+func (s *ClientsService) Create(ctx context.Context, body *ClientCreateRequest, callOpts ...CallOption) (*BillingClient, *Response, error) {
+	req, err := s.client.NewRequest("POST", "clients", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var client BillingClient
+	resp, err := s.client.Do(ctx, req, &client, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &client, resp, nil
+}
+
+// Update updates a client. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#update-a-client
+func (s *ClientsService) Update(ctx context.Context, clientId int, body *ClientUpdateRequest, callOpts ...CallOption) (*BillingClient, *Response, error) {
+	u := fmt.Sprintf("clients/%d", clientId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var client BillingClient
+	resp, err := s.client.Do(ctx, req, &client, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &client, resp, nil
+}
+
+// Delete deletes a client. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/clients-api/clients/clients/#delete-a-client
+func (s *ClientsService) Delete(ctx context.Context, clientId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("clients/%d", clientId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
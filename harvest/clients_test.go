@@ -0,0 +1,100 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/clients", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"clients": [{"id": 5735776}]}`)
+	})
+
+	clients, _, err := client.Clients.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ClientsService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(clients) != want {
+		t.Errorf("ClientsService.List returned %+v clients, want %+v", len(clients), want)
+	}
+}
+
+func TestClientsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/clients/5735776", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 5735776, "name": "ACME Corp"}`)
+	})
+
+	got, _, err := client.Clients.Get(context.Background(), 5735776)
+	if err != nil {
+		t.Fatalf("ClientsService.Get returned error: %v", err)
+	}
+
+	if want := "ACME Corp"; got.Name != want {
+		t.Errorf("ClientsService.Get Name = %v, want %v", got.Name, want)
+	}
+}
+
+func TestClientsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/clients", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 5735776, "name": "ACME Corp"}`)
+	})
+
+	got, _, err := client.Clients.Create(context.Background(), &ClientCreateRequest{Name: "ACME Corp"})
+	if err != nil {
+		t.Fatalf("ClientsService.Create returned error: %v", err)
+	}
+
+	if want := 5735776; got.Id != want {
+		t.Errorf("ClientsService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestClientsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/clients/5735776", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 5735776, "name": "New Name"}`)
+	})
+
+	newName := "New Name"
+	got, _, err := client.Clients.Update(context.Background(), 5735776, &ClientUpdateRequest{Name: &newName})
+	if err != nil {
+		t.Fatalf("ClientsService.Update returned error: %v", err)
+	}
+
+	if got.Name != newName {
+		t.Errorf("ClientsService.Update Name = %v, want %v", got.Name, newName)
+	}
+}
+
+func TestClientsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/clients/5735776", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Clients.Delete(context.Background(), 5735776); err != nil {
+		t.Errorf("ClientsService.Delete returned error: %v", err)
+	}
+}
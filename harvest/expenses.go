@@ -0,0 +1,162 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type ExpensesService service
+
+// Expense is a Harvest expense.
+type Expense struct {
+	Id                int     `json:"id"`
+	ProjectId         int     `json:"project_id"`
+	ExpenseCategoryId int     `json:"expense_category_id"`
+	UserId            int     `json:"user_id"`
+	Notes             string  `json:"notes"`
+	TotalCost         float64 `json:"total_cost"`
+	Units             float64 `json:"units"`
+	Billable          bool    `json:"billable"`
+	IsLocked          bool    `json:"is_locked"`
+	SpentDate         string  `json:"spent_date"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+// ExpensesListOptions specifies the optional parameters to the
+// ExpensesService.List method.
+type ExpensesListOptions struct {
+	UserId       int    `url:"user_id,omitempty"`
+	ProjectId    int    `url:"project_id,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+	From         string `url:"from,omitempty"`
+	To           string `url:"to,omitempty"`
+
+	ListOptions
+}
+
+// ExpenseCreateRequest is the request body for ExpensesService.Create.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#create-an-expense
+type ExpenseCreateRequest struct {
+	ProjectId         int      `json:"project_id"`
+	ExpenseCategoryId int      `json:"expense_category_id"`
+	SpentDate         string   `json:"spent_date"`
+	UserId            *int     `json:"user_id,omitempty"`
+	Notes             *string  `json:"notes,omitempty"`
+	Units             *float64 `json:"units,omitempty"`
+	TotalCost         *float64 `json:"total_cost,omitempty"`
+	Billable          *bool    `json:"billable,omitempty"`
+}
+
+// ExpenseUpdateRequest is the request body for ExpensesService.Update. Only
+// non-nil fields are sent to the API.
+type ExpenseUpdateRequest struct {
+	Notes     *string  `json:"notes,omitempty"`
+	Units     *float64 `json:"units,omitempty"`
+	TotalCost *float64 `json:"total_cost,omitempty"`
+	Billable  *bool    `json:"billable,omitempty"`
+	SpentDate *string  `json:"spent_date,omitempty"`
+}
+
+// List lists expenses. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#list-all-expenses
+func (s *ExpensesService) List(ctx context.Context, opts *ExpensesListOptions, callOpts ...CallOption) ([]*Expense, *Response, error) {
+	u := "expenses"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Expenses []*Expense `json:"expenses"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Expenses, resp, nil
+}
+
+// Get retrieves an expense. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#retrieve-an-expense
+func (s *ExpensesService) Get(ctx context.Context, expenseId int, callOpts ...CallOption) (*Expense, *Response, error) {
+	u := fmt.Sprintf("expenses/%d", expenseId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var expense Expense
+	resp, err := s.client.Do(ctx, req, &expense, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &expense, resp, nil
+}
+
+// Create creates a new expense. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#create-an-expense
+func (s *ExpensesService) Create(ctx context.Context, body *ExpenseCreateRequest, callOpts ...CallOption) (*Expense, *Response, error) {
+	req, err := s.client.NewRequest("POST", "expenses", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var expense Expense
+	resp, err := s.client.Do(ctx, req, &expense, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &expense, resp, nil
+}
+
+// Update updates an expense. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#update-an-expense
+func (s *ExpensesService) Update(ctx context.Context, expenseId int, body *ExpenseUpdateRequest, callOpts ...CallOption) (*Expense, *Response, error) {
+	u := fmt.Sprintf("expenses/%d", expenseId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var expense Expense
+	resp, err := s.client.Do(ctx, req, &expense, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &expense, resp, nil
+}
+
+// Delete deletes an expense. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/expenses-api/expenses/expenses/#delete-an-expense
+func (s *ExpensesService) Delete(ctx context.Context, expenseId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("expenses/%d", expenseId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
@@ -0,0 +1,101 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestExpensesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/expenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"expenses": [{"id": 15296442}]}`)
+	})
+
+	expenses, _, err := client.Expenses.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ExpensesService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(expenses) != want {
+		t.Errorf("ExpensesService.List returned %+v expenses, want %+v", len(expenses), want)
+	}
+}
+
+func TestExpensesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/expenses/15296442", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 15296442, "notes": "mileage"}`)
+	})
+
+	got, _, err := client.Expenses.Get(context.Background(), 15296442)
+	if err != nil {
+		t.Fatalf("ExpensesService.Get returned error: %v", err)
+	}
+
+	if want := "mileage"; got.Notes != want {
+		t.Errorf("ExpensesService.Get Notes = %v, want %v", got.Notes, want)
+	}
+}
+
+func TestExpensesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/expenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 15296442}`)
+	})
+
+	body := &ExpenseCreateRequest{ProjectId: 1, ExpenseCategoryId: 2, SpentDate: "2020-01-01"}
+	got, _, err := client.Expenses.Create(context.Background(), body)
+	if err != nil {
+		t.Fatalf("ExpensesService.Create returned error: %v", err)
+	}
+
+	if want := 15296442; got.Id != want {
+		t.Errorf("ExpensesService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestExpensesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/expenses/15296442", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 15296442, "notes": "updated"}`)
+	})
+
+	notes := "updated"
+	got, _, err := client.Expenses.Update(context.Background(), 15296442, &ExpenseUpdateRequest{Notes: &notes})
+	if err != nil {
+		t.Fatalf("ExpensesService.Update returned error: %v", err)
+	}
+
+	if got.Notes != notes {
+		t.Errorf("ExpensesService.Update Notes = %v, want %v", got.Notes, notes)
+	}
+}
+
+func TestExpensesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/expenses/15296442", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Expenses.Delete(context.Background(), 15296442); err != nil {
+		t.Errorf("ExpensesService.Delete returned error: %v", err)
+	}
+}
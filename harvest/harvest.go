@@ -8,20 +8,58 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 const (
 	defaultBaseURL    = "https://api.harvestapp.com/"
 	defaultApiVersion = "v2/"
 	userAgent         = "go-harvest"
+
+	// defaultRateLimit and defaultRateBurst size the Client's default
+	// RateLimiter to Harvest's published quota for most endpoints: 100
+	// requests per 15 seconds.
+	// https://help.getharvest.com/api-v2/introduction/overview/general/#rate-limiting
+	defaultRateLimit = rate.Limit(100.0 / 15.0)
+	defaultRateBurst = 100
+
+	// defaultReportsRateLimit and defaultReportsBurst size the Client's
+	// default ReportsRateLimiter to the separate, lower quota Harvest
+	// enforces on reports/ endpoints: 100 requests per 15 minutes.
+	// https://help.getharvest.com/api-v2/reports-api/reports/reports/#throttling
+	defaultReportsRateLimit = rate.Limit(100.0 / 900.0)
+	defaultReportsBurst     = 100
 )
 
+// RetryConfig controls how Client.Do retries requests that fail with a
+// transient error: a network error, an HTTP 5xx response, or an HTTP 429
+// Too Many Requests response.
+//
+// MaxRetries is the maximum number of retries to attempt after the initial
+// request. The default value of 0 disables retrying entirely, preserving
+// the client's historical behavior.
+//
+// Between attempts, Do sleeps for min(MaxWait, MinWait * 2^attempt) plus a
+// random duration in [0, Jitter), unless the response is an HTTP 429 or 503
+// carrying a Retry-After header, in which case that value takes precedence.
+type RetryConfig struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+	Jitter     time.Duration
+}
+
 type Client struct {
 	client *http.Client // HTTP client used to communicate with the API.
 
@@ -29,16 +67,48 @@ type Client struct {
 	// BaseURL should always be specified with a trailing slash.
 	BaseURL *url.URL
 
-	AccessToken string // https://help.getharvest.com/api-v2/authentication-api/authentication/authentication/
+	// AccessToken authenticates every request. It is ignored once
+	// tokenSource is set via WithOAuth2TokenSource, which supplies a
+	// refreshed token on every request instead.
+	// https://help.getharvest.com/api-v2/authentication-api/authentication/authentication/
+	AccessToken string
 	AccountId   string // https://help.getharvest.com/api-v2/authentication-api/authentication/authentication/
 
+	// tokenSource, set by WithOAuth2TokenSource, supplies a fresh OAuth2
+	// access token for every request, taking precedence over AccessToken.
+	tokenSource oauth2.TokenSource
+
 	// User agent used when communicating with the Harvest API.
 	UserAgent string // https://help.getharvest.com/api-v2/authentication-api/authentication/authentication/
 
+	// Retry controls automatic retrying of transient failures. The zero
+	// value disables retries.
+	Retry RetryConfig
+
+	// RateLimiter gates every request made through Do against Harvest's
+	// published quota. It defaults to a limiter sized for that quota; set
+	// it to nil to disable limiting, e.g. when testing against a local
+	// server.
+	RateLimiter *rate.Limiter
+
+	// ReportsRateLimiter additionally gates requests to reports/ endpoints,
+	// which Harvest enforces against a separate, lower quota. Set it to nil
+	// to disable limiting just for those endpoints.
+	ReportsRateLimiter *rate.Limiter
+
+	rateMu sync.Mutex
+	rate   Rate // last Rate observed on an HTTP 429 response
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Harvest API.
 	TimeEntries *TimeEntriesService
+	Projects    *ProjectsService
+	Users       *UsersService
+	Tasks       *TasksService
+	Clients     *ClientsService
+	Invoices    *InvoicesService
+	Expenses    *ExpensesService
 }
 
 type service struct {
@@ -77,17 +147,198 @@ func addOptions(s string, opts interface{}) (string, error) {
 	return u.String(), nil
 }
 
-func NewClient(accessToken string, accountId string) *Client {
-	httpClient := &http.Client{}
+// ClientOption configures a Client constructed by NewClientWithOptions.
+type ClientOption func(*Client) error
+
+// WithAccessToken sets the static access token used to authenticate
+// requests. It has no effect if WithOAuth2TokenSource is also supplied.
+func WithAccessToken(accessToken string) ClientOption {
+	return func(c *Client) error {
+		c.AccessToken = accessToken
+		return nil
+	}
+}
+
+// WithAccountID sets the Harvest account ID sent with every request.
+func WithAccountID(accountId string) ClientOption {
+	return func(c *Client) error {
+		c.AccountId = accountId
+		return nil
+	}
+}
+
+// WithBaseURL overrides the API base URL, which defaults to
+// https://api.harvestapp.com/. baseURL must have a trailing slash.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("harvest: invalid base URL: %w", err)
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient sets the *http.Client requests are sent through. hc.
+// Transport (or http.DefaultTransport, if nil) is wrapped with a transport
+// that adds Harvest's authentication and user-agent headers, so callers
+// remain free to compose hc.Transport with cassette-style recorders such as
+// dnaeon/go-vcr, OpenTelemetry instrumentation, or other http.RoundTripper
+// middleware. hc itself is not modified.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) error {
+		if hc == nil {
+			return errors.New("harvest: HTTP client must be non-nil")
+		}
+		c.client = hc
+		return nil
+	}
+}
+
+// WithRetry sets the Client's Retry configuration. See RetryConfig.
+func WithRetry(retry RetryConfig) ClientOption {
+	return func(c *Client) error {
+		c.Retry = retry
+		return nil
+	}
+}
 
+// WithRateLimiter overrides the Client's default RateLimiter. Pass nil to
+// disable rate limiting entirely.
+func WithRateLimiter(limiter *rate.Limiter) ClientOption {
+	return func(c *Client) error {
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// WithReportsRateLimiter overrides the Client's default ReportsRateLimiter.
+// Pass nil to disable rate limiting of reports/ endpoints.
+func WithReportsRateLimiter(limiter *rate.Limiter) ClientOption {
+	return func(c *Client) error {
+		c.ReportsRateLimiter = limiter
+		return nil
+	}
+}
+
+// WithOAuth2TokenSource authenticates the Client using an OAuth2 token
+// obtained, and automatically refreshed, from ts. It takes precedence over
+// any token set with WithAccessToken.
+// https://help.getharvest.com/api-v2/authentication-api/authentication/authentication/#oauth2
+func WithOAuth2TokenSource(ts oauth2.TokenSource) ClientOption {
+	return func(c *Client) error {
+		if ts == nil {
+			return errors.New("harvest: token source must be non-nil")
+		}
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// NewClientWithOptions returns a new Client configured by opts. Authenticate
+// it with either WithAccessToken or WithOAuth2TokenSource, and set its
+// account with WithAccountID.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, AccessToken: accessToken, AccountId: accountId, UserAgent: userAgent}
+	c := &Client{
+		BaseURL:            baseURL,
+		UserAgent:          userAgent,
+		RateLimiter:        rate.NewLimiter(defaultRateLimit, defaultRateBurst),
+		ReportsRateLimiter: rate.NewLimiter(defaultReportsRateLimit, defaultReportsBurst),
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.client == nil {
+		c.client = &http.Client{}
+	}
+	base := c.client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client := *c.client
+	client.Transport = &transport{c: c, base: base}
+	c.client = &client
+
 	c.common.client = c
 	c.TimeEntries = (*TimeEntriesService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
+	c.Tasks = (*TasksService)(&c.common)
+	c.Clients = (*ClientsService)(&c.common)
+	c.Invoices = (*InvoicesService)(&c.common)
+	c.Expenses = (*ExpensesService)(&c.common)
+	return c, nil
+}
+
+// NewClient returns a new Client that sends requests directly over
+// http.DefaultTransport, authenticating with the static accessToken and
+// accountId. To customize the Client further, e.g. with a custom
+// *http.Client, retry/rate-limit settings or OAuth2 token refresh, use
+// NewClientWithOptions instead.
+func NewClient(accessToken string, accountId string) *Client {
+	return NewClientWithHTTPClient(accessToken, accountId, &http.Client{})
+}
+
+// NewClientWithHTTPClient returns a new Client that sends requests through
+// hc. hc.Transport (or http.DefaultTransport, if nil) is wrapped with a
+// transport that adds Harvest's authentication and user-agent headers, so
+// callers remain free to compose hc.Transport with cassette-style recorders
+// such as dnaeon/go-vcr, OpenTelemetry instrumentation, or other
+// http.RoundTripper middleware. hc itself is not modified.
+func NewClientWithHTTPClient(accessToken string, accountId string, hc *http.Client) *Client {
+	c, err := NewClientWithOptions(WithAccessToken(accessToken), WithAccountID(accountId), WithHTTPClient(hc))
+	if err != nil {
+		// WithAccessToken, WithAccountID and a non-nil hc never fail.
+		panic(err)
+	}
 	return c
 }
 
+// transport wraps base, adding the Authorization, Harvest-Account-Id and
+// User-Agent headers that every Harvest API request requires. It reads
+// those values from c on every round trip rather than capturing them once,
+// so changes made to c.AccessToken after construction take effect
+// immediately.
+type transport struct {
+	c    *Client
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	accessToken := t.c.AccessToken
+	if t.c.tokenSource != nil {
+		tok, err := t.c.tokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("harvest: refreshing OAuth2 token: %w", err)
+		}
+		accessToken = tok.AccessToken
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Harvest-Account-Id", t.c.AccountId)
+	if t.c.UserAgent != "" {
+		req.Header.Set("User-Agent", t.c.UserAgent)
+	}
+	return t.base.RoundTrip(req)
+}
+
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
@@ -98,6 +349,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	}
 
 	var buf io.ReadWriter
+	var bodyBytes []byte
 	if body != nil {
 		buf = &bytes.Buffer{}
 		enc := json.NewEncoder(buf)
@@ -106,6 +358,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		if err != nil {
 			return nil, err
 		}
+		bodyBytes = buf.(*bytes.Buffer).Bytes()
 	}
 
 	req, err := http.NewRequest(method, u.String(), buf)
@@ -115,15 +368,54 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
+		// GetBody lets Client.Do rewind and resend this exact body on retry.
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(bodyBytes)), nil
+		}
+	}
+
+	// Authorization, Harvest-Account-Id and User-Agent are added by the
+	// transport installed in NewClientWithOptions, on every actual send.
+	return req, nil
+}
+
+// callConfig holds the effective settings for a single API call, built by
+// applying that call's CallOptions.
+type callConfig struct {
+	timeout time.Duration
+	headers http.Header
+	noRetry bool
+}
+
+// CallOption customizes a single API call, e.g. TimeEntriesService.List,
+// without changing the Client's overall configuration.
+type CallOption func(*callConfig)
+
+// WithCallTimeout bounds a single call to d. Like context.WithTimeout, it
+// can only tighten ctx's existing deadline, never extend it.
+func WithCallTimeout(d time.Duration) CallOption {
+	return func(cc *callConfig) {
+		cc.timeout = d
 	}
+}
 
-	req.Header.Set("Authorization", "Bearer "+c.AccessToken)
-	req.Header.Set("Harvest-Account-Id", c.AccountId)
+// WithCallHeader sets an additional HTTP header on a single call, e.g.
+// WithCallHeader("Idempotency-Key", key) on a POST that must not be
+// duplicated if it is retried.
+func WithCallHeader(key, value string) CallOption {
+	return func(cc *callConfig) {
+		if cc.headers == nil {
+			cc.headers = make(http.Header)
+		}
+		cc.headers.Set(key, value)
+	}
+}
 
-	if c.UserAgent != "" {
-		req.Header.Set("User-Agent", c.UserAgent)
+// WithoutRetry disables the Client's Retry configuration for a single call.
+func WithoutRetry() CallOption {
+	return func(cc *callConfig) {
+		cc.noRetry = true
 	}
-	return req, nil
 }
 
 // Do sends an API request and returns the API response. The API response is
@@ -133,13 +425,63 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 // first decode it.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
-// ctx.Err() will be returned.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+// ctx.Err() will be returned. callOpts customize this call only; see
+// CallOption.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}, callOpts ...CallOption) (*Response, error) {
 	if ctx == nil {
 		return nil, errors.New("context must be non-nil")
 	}
 
-	resp, err := c.client.Do(req)
+	var cc callConfig
+	for _, opt := range callOpts {
+		opt(&cc)
+	}
+
+	for key, values := range cc.headers {
+		for _, value := range values {
+			req.Header.Set(key, value)
+		}
+	}
+
+	if cc.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cc.timeout)
+		defer cancel()
+	}
+
+	maxRetries := c.Retry.MaxRetries
+	if cc.noRetry {
+		maxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := rewindRequestBody(req); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := c.waitForRateLimit(ctx, req); err != nil {
+			return nil, err
+		}
+
+		response, err := c.do(ctx, req, v)
+		if attempt >= maxRetries || !isRetryable(response, err) {
+			return response, err
+		}
+
+		wait := c.retryWait(attempt, response)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// do performs a single attempt of req, without any retrying.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -164,6 +506,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	response := newResponse(resp)
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		c.recordRate(response)
+	}
+
 	err = CheckResponse(resp)
 	if err != nil {
 		return response, err
@@ -186,6 +532,124 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, err
 }
 
+// rewindRequestBody resets req.Body to the start of the buffer captured by
+// NewRequest, so the request can be safely resent. Requests without a body
+// have a nil GetBody and are left untouched.
+func rewindRequestBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// waitForRateLimit blocks until req is permitted to proceed under the
+// Client's configured RateLimiter and, for reports/ endpoints,
+// ReportsRateLimiter. Either limiter may be nil to disable that check.
+func (c *Client) waitForRateLimit(ctx context.Context, req *http.Request) error {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if c.ReportsRateLimiter != nil && isReportsRequest(req) {
+		if err := c.ReportsRateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isReportsRequest reports whether req targets one of Harvest's reports/
+// endpoints, which are throttled against a separate quota.
+func isReportsRequest(req *http.Request) bool {
+	return strings.Contains(req.URL.Path, "/reports/")
+}
+
+// recordRate records the Client's rate limit state as observed on an HTTP
+// 429 response, both on resp and for later retrieval via RateLimit.
+//
+// Harvest's 429 body and headers don't carry a remaining-quota count (only
+// Retry-After, in seconds), so Remaining is always left at its zero value
+// here: on a 429 the quota is, by definition, exhausted. Limit is taken
+// from the local RateLimiter's burst size rather than from the API, since
+// Harvest doesn't echo the configured limit back either.
+func (c *Client) recordRate(resp *Response) {
+	d, ok := retryAfter(resp.Response)
+	if !ok {
+		return
+	}
+
+	r := Rate{Reset: time.Now().Add(d)}
+	if c.RateLimiter != nil {
+		r.Limit = int(c.RateLimiter.Burst())
+	}
+	resp.Rate = r
+
+	c.rateMu.Lock()
+	c.rate = r
+	c.rateMu.Unlock()
+}
+
+// RateLimit returns the Rate observed on the most recent HTTP 429 response,
+// or the zero Rate if none has been seen yet.
+func (c *Client) RateLimit() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
+// isRetryable reports whether a failed request is eligible for a retry:
+// a network-level error, an HTTP 429, or an HTTP 5xx response.
+func isRetryable(resp *Response, err error) bool {
+	if resp == nil {
+		return err != nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryWait computes how long to sleep before the given retry attempt
+// (0-indexed), preferring a Retry-After header on the response when present.
+func (c *Client) retryWait(attempt int, resp *Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Response); ok {
+			return d
+		}
+	}
+
+	// MinWait == 0 is a legitimate "retry immediately" configuration, not
+	// an overflow: only treat the shift result as overflowed when MinWait
+	// itself is positive but the shift pushed it negative.
+	wait := c.Retry.MinWait << attempt
+	if c.Retry.MinWait > 0 && wait <= 0 {
+		wait = c.Retry.MaxWait
+	}
+	if wait > c.Retry.MaxWait {
+		wait = c.Retry.MaxWait
+	}
+	if c.Retry.Jitter > 0 {
+		wait += time.Duration(rand.Int63n(int64(c.Retry.Jitter)))
+	}
+	return wait
+}
+
+// retryAfter parses the Retry-After header of a 429 or 503 response, which
+// Harvest sends as a number of seconds.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
 func sanitizeURL(uri *url.URL) *url.URL {
 	return uri
 }
@@ -252,6 +716,20 @@ type Response struct {
 	PreviousPage int
 	FirstPage    int
 	LastPage     int
+
+	// Rate is the rate limit state observed on this response. It is only
+	// populated when the API returned an HTTP 429; see Client.RateLimit.
+	Rate Rate
+}
+
+// Rate describes the quota state observed from an HTTP 429 response: the
+// configured limit and when the window resets. Remaining is always 0 since
+// Harvest's 429 responses don't report a remaining-quota count; see
+// Client.recordRate.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -1,13 +1,328 @@
 package harvest
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
 )
 
-func TestNewClient(t *testing.T) {
-	c := NewClient()
+// values is a shorthand map of expected URL query or form values, used by
+// testFormValues.
+type values map[string]string
+
+// setup stands up a test HTTP server and a Client configured to talk to it,
+// returning the mux to register handlers on and a teardown func to call at
+// the end of the test (typically via defer).
+func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	client = NewClient("test-access-token", "test-account-id")
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	return client, mux, server.URL, server.Close
+}
+
+// testMethod fails the test if r was not made with the given HTTP method.
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("request method = %v, want %v", got, want)
+	}
+}
+
+// testFormValues fails the test if r's query parameters don't exactly match
+// want.
+func testFormValues(t *testing.T, r *http.Request, want values) {
+	t.Helper()
+	got := r.URL.Query()
+
+	wantValues := url.Values{}
+	for k, v := range want {
+		wantValues.Set(k, v)
+	}
+
+	if got.Encode() != wantValues.Encode() {
+		t.Errorf("request parameters = %v, want %v", got.Encode(), wantValues.Encode())
+	}
+}
 
+func TestNewClient(t *testing.T) {
+	c := NewClient("token", "account")
 	if got, want := c.UserAgent, userAgent; got != want {
 		t.Errorf("NewClient UserAgent is %v, want %v", got, want)
 	}
 }
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *Response
+		err  error
+		want bool
+	}{
+		{"network error", nil, errors.New("dial tcp: connection refused"), true},
+		{"429", &Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}, nil, true},
+		{"500", &Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, nil, true},
+		{"200", &Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryable(tt.resp, tt.err); got != tt.want {
+			t.Errorf("%s: isRetryable = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestClient_retryWait_respectsRetryAfter(t *testing.T) {
+	c := NewClient("token", "account")
+	c.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Second, MaxWait: 30 * time.Second}
+
+	resp := &Response{Response: &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"5"}},
+	}}
+
+	if got, want := c.retryWait(0, resp), 5*time.Second; got != want {
+		t.Errorf("retryWait = %v, want %v", got, want)
+	}
+}
+
+func TestClient_retryWait_zeroMinWait(t *testing.T) {
+	c := NewClient("token", "account")
+	c.Retry = RetryConfig{MaxRetries: 3, MinWait: 0, MaxWait: 30 * time.Second}
+
+	if got, want := c.retryWait(0, nil), time.Duration(0); got != want {
+		t.Errorf("retryWait with MinWait == 0 = %v, want %v", got, want)
+	}
+}
+
+// TestClient_Do_retriesWithRewoundBody exercises Client.Do end-to-end
+// against a server that fails a POST twice before succeeding, verifying
+// both that the retried request's body is the exact body NewRequest
+// captured (not empty, truncated, or a mix of attempts) and that Do
+// eventually returns the successful response.
+func TestClient_Do_retriesWithRewoundBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+
+	var bodies []string
+	mux.HandleFunc("/v2/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		bodies = append(bodies, string(b))
+
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"id": 636709344}`)
+	})
+
+	req, err := client.NewRequest("POST", "time_entries", map[string]string{"notes": "hello"})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	var entry TimeEntry
+	if _, err := client.Do(context.Background(), req, &entry); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if want := 636709344; entry.Id != want {
+		t.Errorf("Do decoded Id = %v, want %v", entry.Id, want)
+	}
+
+	if len(bodies) != 3 {
+		t.Fatalf("server saw %d attempts, want 3", len(bodies))
+	}
+	for i, b := range bodies {
+		if b != bodies[0] {
+			t.Errorf("attempt %d body = %q, want %q (identical to attempt 0)", i, b, bodies[0])
+		}
+		if b == "" {
+			t.Errorf("attempt %d body is empty, want the encoded request body", i)
+		}
+	}
+}
+
+func TestIsReportsRequest(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/v2/reports/time/clients", true},
+		{"/v2/time_entries", false},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest("GET", "https://api.harvestapp.com"+tt.path, nil)
+		if got := isReportsRequest(req); got != tt.want {
+			t.Errorf("isReportsRequest(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestClient_waitForRateLimit_disabled(t *testing.T) {
+	c := NewClient("token", "account")
+	c.RateLimiter = nil
+	c.ReportsRateLimiter = nil
+
+	req, _ := http.NewRequest("GET", "https://api.harvestapp.com/v2/reports/time/clients", nil)
+	if err := c.waitForRateLimit(context.Background(), req); err != nil {
+		t.Errorf("waitForRateLimit with nil limiters returned error: %v", err)
+	}
+}
+
+func TestClient_recordRate(t *testing.T) {
+	c := NewClient("token", "account")
+
+	resp := &Response{Response: &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"5"}},
+	}}
+	c.recordRate(resp)
+
+	if got, want := resp.Rate.Limit, int(c.RateLimiter.Burst()); got != want {
+		t.Errorf("Rate.Limit = %v, want %v", got, want)
+	}
+	if got, want := resp.Rate.Remaining, 0; got != want {
+		t.Errorf("Rate.Remaining = %v, want %v (Harvest's 429 response never reports one)", got, want)
+	}
+	if got, want := c.RateLimit(), resp.Rate; got != want {
+		t.Errorf("RateLimit() = %+v, want %+v", got, want)
+	}
+}
+
+// recordingTransport saves the last request it was asked to round-trip
+// before delegating to base, simulating a cassette recorder or tracing
+// transport composed underneath the Client's auth headers.
+type recordingTransport struct {
+	base    http.RoundTripper
+	lastReq *http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.lastReq = req
+	return rt.base.RoundTrip(req)
+}
+
+func TestNewClientWithHTTPClient_composesTransport(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	rec := &recordingTransport{base: http.DefaultTransport}
+	c := NewClientWithHTTPClient("token", "account", &http.Client{Transport: rec})
+	c.BaseURL, _ = url.Parse(serverURL + "/")
+
+	if _, _, err := c.Users.Me(context.Background()); err != nil {
+		t.Fatalf("Users.Me returned error: %v", err)
+	}
+
+	if rec.lastReq == nil {
+		t.Fatal("recordingTransport did not see the request")
+	}
+	if got, want := rec.lastReq.Header.Get("Authorization"), "Bearer token"; got != want {
+		t.Errorf("Authorization header = %q, want %q", got, want)
+	}
+	if got, want := rec.lastReq.Header.Get("Harvest-Account-Id"), "account"; got != want {
+		t.Errorf("Harvest-Account-Id header = %q, want %q", got, want)
+	}
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	c, err := NewClientWithOptions(WithAccessToken("token"), WithAccountID("account"))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if got, want := c.AccessToken, "token"; got != want {
+		t.Errorf("AccessToken = %v, want %v", got, want)
+	}
+	if got, want := c.AccountId, "account"; got != want {
+		t.Errorf("AccountId = %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, userAgent; got != want {
+		t.Errorf("UserAgent = %v, want %v", got, want)
+	}
+}
+
+func TestNewClientWithOptions_invalidBaseURL(t *testing.T) {
+	if _, err := NewClientWithOptions(WithBaseURL("://bad-url")); err == nil {
+		t.Error("NewClientWithOptions with an invalid base URL returned nil error")
+	}
+}
+
+func TestNewClientWithOptions_oauth2TokenSource(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "Bearer refreshed-token"; got != want {
+			t.Errorf("Authorization header = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "refreshed-token"})
+	c, err := NewClientWithOptions(WithAccountID("account"), WithOAuth2TokenSource(ts))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	c.BaseURL, _ = url.Parse(serverURL + "/")
+
+	if _, _, err := c.Users.Me(context.Background()); err != nil {
+		t.Fatalf("Users.Me returned error: %v", err)
+	}
+}
+
+func TestClient_Do_callOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Idempotency-Key"), "abc123"; got != want {
+			t.Errorf("Idempotency-Key header = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	if _, _, err := client.Users.Me(context.Background(), WithCallHeader("Idempotency-Key", "abc123")); err != nil {
+		t.Fatalf("Users.Me returned error: %v", err)
+	}
+}
+
+func TestClient_Do_withoutRetry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+
+	var attempts int
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, _, err := client.Users.Me(context.Background(), WithoutRetry()); err == nil {
+		t.Fatal("Users.Me returned nil error for a 500 response")
+	}
+
+	if attempts != 1 {
+		t.Errorf("attempts = %v, want 1 (WithoutRetry should disable retrying)", attempts)
+	}
+}
@@ -0,0 +1,155 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type InvoicesService service
+
+// Invoice is a Harvest invoice.
+type Invoice struct {
+	Id        int     `json:"id"`
+	ClientId  int     `json:"client_id"`
+	Number    string  `json:"number"`
+	State     string  `json:"state"`
+	Subject   string  `json:"subject"`
+	Currency  string  `json:"currency"`
+	Amount    float64 `json:"amount"`
+	DueAmount float64 `json:"due_amount"`
+	IssueDate string  `json:"issue_date"`
+	DueDate   string  `json:"due_date"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// InvoicesListOptions specifies the optional parameters to the
+// InvoicesService.List method.
+type InvoicesListOptions struct {
+	ClientId     int    `url:"client_id,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+	From         string `url:"from,omitempty"`
+	To           string `url:"to,omitempty"`
+
+	ListOptions
+}
+
+// InvoiceCreateRequest is the request body for InvoicesService.Create.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#create-a-free-form-invoice
+type InvoiceCreateRequest struct {
+	ClientId  int     `json:"client_id"`
+	Subject   *string `json:"subject,omitempty"`
+	IssueDate *string `json:"issue_date,omitempty"`
+	DueDate   *string `json:"due_date,omitempty"`
+}
+
+// InvoiceUpdateRequest is the request body for InvoicesService.Update. Only
+// non-nil fields are sent to the API.
+type InvoiceUpdateRequest struct {
+	Subject   *string `json:"subject,omitempty"`
+	IssueDate *string `json:"issue_date,omitempty"`
+	DueDate   *string `json:"due_date,omitempty"`
+}
+
+// List lists invoices. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#list-all-invoices
+func (s *InvoicesService) List(ctx context.Context, opts *InvoicesListOptions, callOpts ...CallOption) ([]*Invoice, *Response, error) {
+	u := "invoices"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Invoices []*Invoice `json:"invoices"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Invoices, resp, nil
+}
+
+// Get retrieves an invoice. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#retrieve-an-invoice
+func (s *InvoicesService) Get(ctx context.Context, invoiceId int, callOpts ...CallOption) (*Invoice, *Response, error) {
+	u := fmt.Sprintf("invoices/%d", invoiceId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var invoice Invoice
+	resp, err := s.client.Do(ctx, req, &invoice, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &invoice, resp, nil
+}
+
+// Create creates a new free-form invoice. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#create-a-free-form-invoice
+func (s *InvoicesService) Create(ctx context.Context, body *InvoiceCreateRequest, callOpts ...CallOption) (*Invoice, *Response, error) {
+	req, err := s.client.NewRequest("POST", "invoices", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var invoice Invoice
+	resp, err := s.client.Do(ctx, req, &invoice, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &invoice, resp, nil
+}
+
+// Update updates an invoice. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#update-an-invoice
+func (s *InvoicesService) Update(ctx context.Context, invoiceId int, body *InvoiceUpdateRequest, callOpts ...CallOption) (*Invoice, *Response, error) {
+	u := fmt.Sprintf("invoices/%d", invoiceId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var invoice Invoice
+	resp, err := s.client.Do(ctx, req, &invoice, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &invoice, resp, nil
+}
+
+// Delete deletes an invoice. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/invoices-api/invoices/invoices/#delete-an-invoice
+func (s *InvoicesService) Delete(ctx context.Context, invoiceId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("invoices/%d", invoiceId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
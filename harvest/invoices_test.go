@@ -0,0 +1,100 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestInvoicesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/invoices", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"invoices": [{"id": 13150403}]}`)
+	})
+
+	invoices, _, err := client.Invoices.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("InvoicesService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(invoices) != want {
+		t.Errorf("InvoicesService.List returned %+v invoices, want %+v", len(invoices), want)
+	}
+}
+
+func TestInvoicesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/invoices/13150403", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 13150403, "subject": "Website"}`)
+	})
+
+	got, _, err := client.Invoices.Get(context.Background(), 13150403)
+	if err != nil {
+		t.Fatalf("InvoicesService.Get returned error: %v", err)
+	}
+
+	if want := "Website"; got.Subject != want {
+		t.Errorf("InvoicesService.Get Subject = %v, want %v", got.Subject, want)
+	}
+}
+
+func TestInvoicesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/invoices", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 13150403}`)
+	})
+
+	got, _, err := client.Invoices.Create(context.Background(), &InvoiceCreateRequest{ClientId: 1})
+	if err != nil {
+		t.Fatalf("InvoicesService.Create returned error: %v", err)
+	}
+
+	if want := 13150403; got.Id != want {
+		t.Errorf("InvoicesService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestInvoicesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/invoices/13150403", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 13150403, "subject": "Updated"}`)
+	})
+
+	subject := "Updated"
+	got, _, err := client.Invoices.Update(context.Background(), 13150403, &InvoiceUpdateRequest{Subject: &subject})
+	if err != nil {
+		t.Fatalf("InvoicesService.Update returned error: %v", err)
+	}
+
+	if got.Subject != subject {
+		t.Errorf("InvoicesService.Update Subject = %v, want %v", got.Subject, subject)
+	}
+}
+
+func TestInvoicesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/invoices/13150403", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Invoices.Delete(context.Background(), 13150403); err != nil {
+		t.Errorf("InvoicesService.Delete returned error: %v", err)
+	}
+}
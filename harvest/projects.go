@@ -0,0 +1,175 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type ProjectsService service
+
+// Project is a Harvest project. When embedded in a TimeEntry, only a
+// subset of these fields (Id, Name, Code) is populated.
+type Project struct {
+	Id         int     `json:"id"`
+	Name       string  `json:"name"`
+	Code       string  `json:"code"`
+	IsActive   bool    `json:"is_active"`
+	IsBillable bool    `json:"is_billable"`
+	IsFixedFee bool    `json:"is_fixed_fee"`
+	BillBy     string  `json:"bill_by"`
+	HourlyRate float64 `json:"hourly_rate"`
+	Budget     float64 `json:"budget"`
+	BudgetBy   string  `json:"budget_by"`
+	Notes      string  `json:"notes"`
+	StartsOn   string  `json:"starts_on"`
+	EndsOn     string  `json:"ends_on"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+// ProjectsListOptions specifies the optional parameters to the
+// ProjectsService.List method.
+type ProjectsListOptions struct {
+	IsActive     bool   `url:"is_active,omitempty"`
+	ClientId     int    `url:"client_id,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+
+	ListOptions
+}
+
+// ProjectCreateRequest is the request body for ProjectsService.Create.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#create-a-project
+type ProjectCreateRequest struct {
+	ClientId   int      `json:"client_id"`
+	Name       string   `json:"name"`
+	IsBillable bool     `json:"is_billable"`
+	BillBy     string   `json:"bill_by"`
+	BudgetBy   string   `json:"budget_by"`
+	Code       *string  `json:"code,omitempty"`
+	IsActive   *bool    `json:"is_active,omitempty"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty"`
+	Budget     *float64 `json:"budget,omitempty"`
+	Notes      *string  `json:"notes,omitempty"`
+	StartsOn   *string  `json:"starts_on,omitempty"`
+	EndsOn     *string  `json:"ends_on,omitempty"`
+}
+
+// ProjectUpdateRequest is the request body for ProjectsService.Update. Only
+// non-nil fields are sent to the API.
+type ProjectUpdateRequest struct {
+	ClientId   *int     `json:"client_id,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	Code       *string  `json:"code,omitempty"`
+	IsActive   *bool    `json:"is_active,omitempty"`
+	IsBillable *bool    `json:"is_billable,omitempty"`
+	BillBy     *string  `json:"bill_by,omitempty"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty"`
+	Budget     *float64 `json:"budget,omitempty"`
+	BudgetBy   *string  `json:"budget_by,omitempty"`
+	Notes      *string  `json:"notes,omitempty"`
+	StartsOn   *string  `json:"starts_on,omitempty"`
+	EndsOn     *string  `json:"ends_on,omitempty"`
+}
+
+// List lists projects. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#list-all-projects
+func (s *ProjectsService) List(ctx context.Context, opts *ProjectsListOptions, callOpts ...CallOption) ([]*Project, *Response, error) {
+	u := "projects"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Projects []*Project `json:"projects"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Projects, resp, nil
+}
+
+// Get retrieves a project. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#retrieve-a-project
+func (s *ProjectsService) Get(ctx context.Context, projectId int, callOpts ...CallOption) (*Project, *Response, error) {
+	u := fmt.Sprintf("projects/%d", projectId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project Project
+	resp, err := s.client.Do(ctx, req, &project, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &project, resp, nil
+}
+
+// Create creates a new project. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#create-a-project
+func (s *ProjectsService) Create(ctx context.Context, body *ProjectCreateRequest, callOpts ...CallOption) (*Project, *Response, error) {
+	req, err := s.client.NewRequest("POST", "projects", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project Project
+	resp, err := s.client.Do(ctx, req, &project, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &project, resp, nil
+}
+
+// Update updates a project. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#update-a-project
+func (s *ProjectsService) Update(ctx context.Context, projectId int, body *ProjectUpdateRequest, callOpts ...CallOption) (*Project, *Response, error) {
+	u := fmt.Sprintf("projects/%d", projectId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project Project
+	resp, err := s.client.Do(ctx, req, &project, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &project, resp, nil
+}
+
+// Delete deletes a project. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/projects-api/projects/projects/#delete-a-project
+func (s *ProjectsService) Delete(ctx context.Context, projectId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("projects/%d", projectId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
@@ -0,0 +1,101 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"projects": [{"id": 14308069}]}`)
+	})
+
+	projects, _, err := client.Projects.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("ProjectsService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(projects) != want {
+		t.Errorf("ProjectsService.List returned %+v projects, want %+v", len(projects), want)
+	}
+}
+
+func TestProjectsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects/14308069", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 14308069, "name": "Website"}`)
+	})
+
+	got, _, err := client.Projects.Get(context.Background(), 14308069)
+	if err != nil {
+		t.Fatalf("ProjectsService.Get returned error: %v", err)
+	}
+
+	if want := "Website"; got.Name != want {
+		t.Errorf("ProjectsService.Get Name = %v, want %v", got.Name, want)
+	}
+}
+
+func TestProjectsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 14308069}`)
+	})
+
+	body := &ProjectCreateRequest{ClientId: 1, Name: "Website", BillBy: "Project", BudgetBy: "project"}
+	got, _, err := client.Projects.Create(context.Background(), body)
+	if err != nil {
+		t.Fatalf("ProjectsService.Create returned error: %v", err)
+	}
+
+	if want := 14308069; got.Id != want {
+		t.Errorf("ProjectsService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestProjectsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects/14308069", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 14308069, "name": "Renamed"}`)
+	})
+
+	name := "Renamed"
+	got, _, err := client.Projects.Update(context.Background(), 14308069, &ProjectUpdateRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("ProjectsService.Update returned error: %v", err)
+	}
+
+	if got.Name != name {
+		t.Errorf("ProjectsService.Update Name = %v, want %v", got.Name, name)
+	}
+}
+
+func TestProjectsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/projects/14308069", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Projects.Delete(context.Background(), 14308069); err != nil {
+		t.Errorf("ProjectsService.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type TasksService service
+
+// Task is a Harvest task. When embedded in a TimeEntry, only a subset of
+// these fields (Id, Name) is populated.
+type Task struct {
+	Id                int     `json:"id"`
+	Name              string  `json:"name"`
+	BillableByDefault bool    `json:"billable_by_default"`
+	DefaultHourlyRate float64 `json:"default_hourly_rate"`
+	IsDefault         bool    `json:"is_default"`
+	IsActive          bool    `json:"is_active"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+// TasksListOptions specifies the optional parameters to the
+// TasksService.List method.
+type TasksListOptions struct {
+	IsActive     bool   `url:"is_active,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+
+	ListOptions
+}
+
+// TaskCreateRequest is the request body for TasksService.Create.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#create-a-task
+type TaskCreateRequest struct {
+	Name              string   `json:"name"`
+	BillableByDefault *bool    `json:"billable_by_default,omitempty"`
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty"`
+	IsDefault         *bool    `json:"is_default,omitempty"`
+	IsActive          *bool    `json:"is_active,omitempty"`
+}
+
+// TaskUpdateRequest is the request body for TasksService.Update. Only
+// non-nil fields are sent to the API.
+type TaskUpdateRequest struct {
+	Name              *string  `json:"name,omitempty"`
+	BillableByDefault *bool    `json:"billable_by_default,omitempty"`
+	DefaultHourlyRate *float64 `json:"default_hourly_rate,omitempty"`
+	IsDefault         *bool    `json:"is_default,omitempty"`
+	IsActive          *bool    `json:"is_active,omitempty"`
+}
+
+// List lists tasks. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#list-all-tasks
+func (s *TasksService) List(ctx context.Context, opts *TasksListOptions, callOpts ...CallOption) ([]*Task, *Response, error) {
+	u := "tasks"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Tasks []*Task `json:"tasks"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Tasks, resp, nil
+}
+
+// Get retrieves a task. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#retrieve-a-task
+func (s *TasksService) Get(ctx context.Context, taskId int, callOpts ...CallOption) (*Task, *Response, error) {
+	u := fmt.Sprintf("tasks/%d", taskId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var task Task
+	resp, err := s.client.Do(ctx, req, &task, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &task, resp, nil
+}
+
+// Create creates a new task. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#create-a-task
+func (s *TasksService) Create(ctx context.Context, body *TaskCreateRequest, callOpts ...CallOption) (*Task, *Response, error) {
+	req, err := s.client.NewRequest("POST", "tasks", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var task Task
+	resp, err := s.client.Do(ctx, req, &task, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &task, resp, nil
+}
+
+// Update updates a task. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#update-a-task
+func (s *TasksService) Update(ctx context.Context, taskId int, body *TaskUpdateRequest, callOpts ...CallOption) (*Task, *Response, error) {
+	u := fmt.Sprintf("tasks/%d", taskId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var task Task
+	resp, err := s.client.Do(ctx, req, &task, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &task, resp, nil
+}
+
+// Delete deletes a task. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/tasks-api/tasks/tasks/#delete-a-task
+func (s *TasksService) Delete(ctx context.Context, taskId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("tasks/%d", taskId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
@@ -0,0 +1,100 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTasksService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tasks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tasks": [{"id": 8083365}]}`)
+	})
+
+	tasks, _, err := client.Tasks.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("TasksService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(tasks) != want {
+		t.Errorf("TasksService.List returned %+v tasks, want %+v", len(tasks), want)
+	}
+}
+
+func TestTasksService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tasks/8083365", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 8083365, "name": "Design"}`)
+	})
+
+	got, _, err := client.Tasks.Get(context.Background(), 8083365)
+	if err != nil {
+		t.Fatalf("TasksService.Get returned error: %v", err)
+	}
+
+	if want := "Design"; got.Name != want {
+		t.Errorf("TasksService.Get Name = %v, want %v", got.Name, want)
+	}
+}
+
+func TestTasksService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tasks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 8083365}`)
+	})
+
+	got, _, err := client.Tasks.Create(context.Background(), &TaskCreateRequest{Name: "Design"})
+	if err != nil {
+		t.Fatalf("TasksService.Create returned error: %v", err)
+	}
+
+	if want := 8083365; got.Id != want {
+		t.Errorf("TasksService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestTasksService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tasks/8083365", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 8083365, "name": "Renamed"}`)
+	})
+
+	name := "Renamed"
+	got, _, err := client.Tasks.Update(context.Background(), 8083365, &TaskUpdateRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("TasksService.Update returned error: %v", err)
+	}
+
+	if got.Name != name {
+		t.Errorf("TasksService.Update Name = %v, want %v", got.Name, name)
+	}
+}
+
+func TestTasksService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tasks/8083365", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Tasks.Delete(context.Background(), 8083365); err != nil {
+		t.Errorf("TasksService.Delete returned error: %v", err)
+	}
+}
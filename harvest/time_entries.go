@@ -2,25 +2,16 @@ package harvest
 
 import (
 	"context"
+	"fmt"
+	"io"
 )
 
 type TimeEntriesService service
 
-type Project struct {
-	Id   int    `json:"id"`
-	Name string `json:"name"`
-}
-
-type User struct {
-	Id   int    `json:"id"`
-	Name string `json:"name"`
-}
-
-type Task struct {
-	Id   int    `json:"id"`
-	Name string `json:"name"`
-}
-
+// TimeEntry embeds Project, User and Task as returned by Harvest: the same
+// resources ProjectsService, UsersService and TasksService fetch in full,
+// though a time entry response only ever populates their Id, Name (and,
+// for Project, Code) fields.
 type TimeEntry struct {
 	Id        int     `json:"id"`
 	Hours     float64 `json:"hours"`
@@ -29,6 +20,31 @@ type TimeEntry struct {
 	User      User    `json:"user"`
 	Task      Task    `json:"task"`
 	SpentDate string  `json:"spent_date"`
+	IsRunning bool    `json:"is_running"`
+	IsLocked  bool    `json:"is_locked"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// TimeEntryCreateRequest is the request body for TimeEntriesService.Create.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#create-a-time-entry-via-duration
+type TimeEntryCreateRequest struct {
+	ProjectId int      `json:"project_id"`
+	TaskId    int      `json:"task_id"`
+	SpentDate string   `json:"spent_date"`
+	UserId    *int     `json:"user_id,omitempty"`
+	Hours     *float64 `json:"hours,omitempty"`
+	Notes     *string  `json:"notes,omitempty"`
+}
+
+// TimeEntryUpdateRequest is the request body for TimeEntriesService.Update.
+// Only non-nil fields are sent to the API.
+type TimeEntryUpdateRequest struct {
+	ProjectId *int     `json:"project_id,omitempty"`
+	TaskId    *int     `json:"task_id,omitempty"`
+	SpentDate *string  `json:"spent_date,omitempty"`
+	Hours     *float64 `json:"hours,omitempty"`
+	Notes     *string  `json:"notes,omitempty"`
 }
 
 // TimeEntriesListOptions specifies the optional parameters to the
@@ -40,9 +56,10 @@ type TimeEntriesListOptions struct {
 	ListOptions
 }
 
-// List lists time entries.
+// List lists time entries. callOpts customize this call only; see
+// CallOption.
 // https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#list-all-time-entries
-func (t *TimeEntriesService) List(ctx context.Context, opts *TimeEntriesListOptions) ([]*TimeEntry, *Response, error) {
+func (t *TimeEntriesService) List(ctx context.Context, opts *TimeEntriesListOptions, callOpts ...CallOption) ([]*TimeEntry, *Response, error) {
 	u := "time_entries"
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -60,7 +77,7 @@ func (t *TimeEntriesService) List(ctx context.Context, opts *TimeEntriesListOpti
 	}
 	var page Page
 
-	resp, err := t.client.Do(ctx, req, &page)
+	resp, err := t.client.Do(ctx, req, &page, callOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -69,3 +86,230 @@ func (t *TimeEntriesService) List(ctx context.Context, opts *TimeEntriesListOpti
 
 	return page.TimeEntries, resp, nil
 }
+
+// Get retrieves a time entry. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#retrieve-a-time-entry
+func (t *TimeEntriesService) Get(ctx context.Context, timeEntryId int, callOpts ...CallOption) (*TimeEntry, *Response, error) {
+	u := fmt.Sprintf("time_entries/%d", timeEntryId)
+
+	req, err := t.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry TimeEntry
+	resp, err := t.client.Do(ctx, req, &entry, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &entry, resp, nil
+}
+
+// Create creates a new time entry. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#create-a-time-entry-via-duration
+func (t *TimeEntriesService) Create(ctx context.Context, body *TimeEntryCreateRequest, callOpts ...CallOption) (*TimeEntry, *Response, error) {
+	req, err := t.client.NewRequest("POST", "time_entries", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry TimeEntry
+	resp, err := t.client.Do(ctx, req, &entry, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &entry, resp, nil
+}
+
+// Update updates a time entry. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#update-a-time-entry
+func (t *TimeEntriesService) Update(ctx context.Context, timeEntryId int, body *TimeEntryUpdateRequest, callOpts ...CallOption) (*TimeEntry, *Response, error) {
+	u := fmt.Sprintf("time_entries/%d", timeEntryId)
+
+	req, err := t.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry TimeEntry
+	resp, err := t.client.Do(ctx, req, &entry, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &entry, resp, nil
+}
+
+// Delete deletes a time entry. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#delete-a-time-entry
+func (t *TimeEntriesService) Delete(ctx context.Context, timeEntryId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("time_entries/%d", timeEntryId)
+
+	req, err := t.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.client.Do(ctx, req, nil, callOpts...)
+}
+
+// Restart restarts a stopped time entry. callOpts customize this call only;
+// see CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#restart-a-time-entry
+func (t *TimeEntriesService) Restart(ctx context.Context, timeEntryId int, callOpts ...CallOption) (*TimeEntry, *Response, error) {
+	u := fmt.Sprintf("time_entries/%d/restart", timeEntryId)
+
+	req, err := t.client.NewRequest("PATCH", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry TimeEntry
+	resp, err := t.client.Do(ctx, req, &entry, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &entry, resp, nil
+}
+
+// Stop stops a running time entry. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#stop-a-running-time-entry
+func (t *TimeEntriesService) Stop(ctx context.Context, timeEntryId int, callOpts ...CallOption) (*TimeEntry, *Response, error) {
+	u := fmt.Sprintf("time_entries/%d/stop", timeEntryId)
+
+	req, err := t.client.NewRequest("PATCH", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entry TimeEntry
+	resp, err := t.client.Do(ctx, req, &entry, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &entry, resp, nil
+}
+
+// PageInfo describes the caller's current position within a paginated
+// List call, as last reported by the Harvest API.
+type PageInfo struct {
+	Page       int
+	TotalPages int
+}
+
+// TimeEntriesIterator walks every time entry matching a set of list
+// options, transparently fetching subsequent pages as the caller consumes
+// entries via Next.
+type TimeEntriesIterator struct {
+	service *TimeEntriesService
+	opts    TimeEntriesListOptions
+
+	buf      []*TimeEntry
+	pageInfo PageInfo
+	done     bool
+}
+
+// Iterator returns a TimeEntriesIterator over all time entries matching
+// opts, fetching pages from the Harvest API on demand. A nil opts behaves
+// like an empty TimeEntriesListOptions.
+func (t *TimeEntriesService) Iterator(opts *TimeEntriesListOptions) *TimeEntriesIterator {
+	it := &TimeEntriesIterator{service: t}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.Page == 0 {
+		it.opts.Page = 1
+	}
+	return it
+}
+
+// Next returns the next time entry, fetching additional pages as needed.
+// It returns io.EOF once every matching time entry has been returned.
+func (it *TimeEntriesIterator) Next(ctx context.Context) (*TimeEntry, error) {
+	if len(it.buf) == 0 {
+		if it.done {
+			return nil, io.EOF
+		}
+		if err := it.fetch(ctx); err != nil {
+			return nil, err
+		}
+		if len(it.buf) == 0 {
+			return nil, io.EOF
+		}
+	}
+
+	entry := it.buf[0]
+	it.buf = it.buf[1:]
+	return entry, nil
+}
+
+// PageInfo reports the page the iterator last fetched and the total
+// number of pages available.
+func (it *TimeEntriesIterator) PageInfo() PageInfo {
+	return it.pageInfo
+}
+
+// Pages calls f once per page of time entries matching the iterator's
+// options, fetching pages from the Harvest API until they are exhausted or
+// f returns an error.
+func (t *TimeEntriesService) Pages(ctx context.Context, opts *TimeEntriesListOptions, f func([]*TimeEntry) error) error {
+	it := t.Iterator(opts)
+	for {
+		page, err := it.fetchPage(ctx)
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 {
+			return nil
+		}
+		if err := f(page); err != nil {
+			return err
+		}
+		if it.done {
+			return nil
+		}
+	}
+}
+
+// fetch populates it.buf with the next page of time entries.
+func (it *TimeEntriesIterator) fetch(ctx context.Context) error {
+	page, err := it.fetchPage(ctx)
+	if err != nil {
+		return err
+	}
+	it.buf = page
+	return nil
+}
+
+// fetchPage retrieves the current page and advances the iterator's cursor
+// to the next one, marking it done once the API reports no further pages.
+func (it *TimeEntriesIterator) fetchPage(ctx context.Context) ([]*TimeEntry, error) {
+	if it.done {
+		return nil, nil
+	}
+
+	opts := it.opts
+	entries, resp, err := it.service.List(ctx, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	it.pageInfo = PageInfo{Page: opts.Page, TotalPages: resp.LastPage}
+
+	if opts.Page >= resp.LastPage {
+		it.done = true
+	} else {
+		it.opts.Page = resp.NextPage
+	}
+
+	return entries, nil
+}
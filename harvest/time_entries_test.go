@@ -3,6 +3,7 @@ package harvest
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 )
@@ -31,3 +32,159 @@ func TestTimeEntriesService_List(t *testing.T) {
 		t.Errorf("TimeEntriesService.List returned %+v entries, want %+v", len(timeEntries), want)
 	}
 }
+
+func TestTimeEntriesIterator_Next(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "1", "":
+			fmt.Fprint(w, `{"time_entries": [{"id": 1}, {"id": 2}], "page": 1, "total_pages": 2, "next_page": 2}`)
+		case "2":
+			fmt.Fprint(w, `{"time_entries": [{"id": 3}], "page": 2, "total_pages": 2}`)
+		default:
+			t.Fatalf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	it := client.TimeEntries.Iterator(nil)
+
+	var ids []int
+	for {
+		entry, err := it.Next(context.Background())
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next returned error: %v", err)
+		}
+		ids = append(ids, entry.Id)
+	}
+
+	want := []int{1, 2, 3}
+	if len(ids) != len(want) {
+		t.Fatalf("Iterator returned %+v entries, want %+v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("entry %d = %d, want %d", i, ids[i], want[i])
+		}
+	}
+
+	if got, want := it.PageInfo(), (PageInfo{Page: 2, TotalPages: 2}); got != want {
+		t.Errorf("PageInfo = %+v, want %+v", got, want)
+	}
+}
+
+func TestTimeEntriesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries/636709344", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 636709344, "notes": "hello"}`)
+	})
+
+	got, _, err := client.TimeEntries.Get(context.Background(), 636709344)
+	if err != nil {
+		t.Fatalf("TimeEntriesService.Get returned error: %v", err)
+	}
+
+	if want := "hello"; got.Notes != want {
+		t.Errorf("TimeEntriesService.Get Notes = %v, want %v", got.Notes, want)
+	}
+}
+
+func TestTimeEntriesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 636709344}`)
+	})
+
+	body := &TimeEntryCreateRequest{ProjectId: 1, TaskId: 2, SpentDate: "2020-01-01"}
+	got, _, err := client.TimeEntries.Create(context.Background(), body)
+	if err != nil {
+		t.Fatalf("TimeEntriesService.Create returned error: %v", err)
+	}
+
+	if want := 636709344; got.Id != want {
+		t.Errorf("TimeEntriesService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestTimeEntriesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries/636709344", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 636709344, "notes": "updated"}`)
+	})
+
+	notes := "updated"
+	got, _, err := client.TimeEntries.Update(context.Background(), 636709344, &TimeEntryUpdateRequest{Notes: &notes})
+	if err != nil {
+		t.Fatalf("TimeEntriesService.Update returned error: %v", err)
+	}
+
+	if got.Notes != notes {
+		t.Errorf("TimeEntriesService.Update Notes = %v, want %v", got.Notes, notes)
+	}
+}
+
+func TestTimeEntriesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries/636709344", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.TimeEntries.Delete(context.Background(), 636709344); err != nil {
+		t.Errorf("TimeEntriesService.Delete returned error: %v", err)
+	}
+}
+
+func TestTimeEntriesService_Restart(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries/636709344/restart", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 636709344, "is_running": true}`)
+	})
+
+	got, _, err := client.TimeEntries.Restart(context.Background(), 636709344)
+	if err != nil {
+		t.Fatalf("TimeEntriesService.Restart returned error: %v", err)
+	}
+
+	if !got.IsRunning {
+		t.Errorf("TimeEntriesService.Restart IsRunning = %v, want true", got.IsRunning)
+	}
+}
+
+func TestTimeEntriesService_Stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/time_entries/636709344/stop", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 636709344, "is_running": false}`)
+	})
+
+	got, _, err := client.TimeEntries.Stop(context.Background(), 636709344)
+	if err != nil {
+		t.Fatalf("TimeEntriesService.Stop returned error: %v", err)
+	}
+
+	if got.IsRunning {
+		t.Errorf("TimeEntriesService.Stop IsRunning = %v, want false", got.IsRunning)
+	}
+}
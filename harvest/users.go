@@ -0,0 +1,174 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersService service
+
+// User is a Harvest user. When embedded in a TimeEntry, only a subset of
+// these fields (Id, Name) is populated.
+type User struct {
+	Id                int     `json:"id"`
+	FirstName         string  `json:"first_name"`
+	LastName          string  `json:"last_name"`
+	Name              string  `json:"name"`
+	Email             string  `json:"email"`
+	IsActive          bool    `json:"is_active"`
+	IsAdmin           bool    `json:"is_admin"`
+	IsProjectManager  bool    `json:"is_project_manager"`
+	DefaultHourlyRate float64 `json:"default_hourly_rate"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+// UsersListOptions specifies the optional parameters to the
+// UsersService.List method.
+type UsersListOptions struct {
+	IsActive     bool   `url:"is_active,omitempty"`
+	UpdatedSince string `url:"updated_since,omitempty"`
+
+	ListOptions
+}
+
+// UserCreateRequest is the request body for UsersService.Create.
+// https://help.getharvest.com/api-v2/users-api/users/users/#create-a-user
+type UserCreateRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	IsAdmin   *bool  `json:"is_admin,omitempty"`
+	IsActive  *bool  `json:"is_active,omitempty"`
+}
+
+// UserUpdateRequest is the request body for UsersService.Update. Only
+// non-nil fields are sent to the API.
+type UserUpdateRequest struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	IsAdmin   *bool   `json:"is_admin,omitempty"`
+	IsActive  *bool   `json:"is_active,omitempty"`
+}
+
+// List lists users. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#list-all-users
+func (s *UsersService) List(ctx context.Context, opts *UsersListOptions, callOpts ...CallOption) ([]*User, *Response, error) {
+	u := "users"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type Page struct {
+		Pagination
+		Users []*User `json:"users"`
+	}
+	var page Page
+
+	resp, err := s.client.Do(ctx, req, &page, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.populatePageValues(page.Pagination)
+
+	return page.Users, resp, nil
+}
+
+// Get retrieves a user. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#retrieve-a-user
+func (s *UsersService) Get(ctx context.Context, userId int, callOpts ...CallOption) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d", userId)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user User
+	resp, err := s.client.Do(ctx, req, &user, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// Me retrieves the currently authenticated user. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#retrieve-the-currently-authenticated-user
+func (s *UsersService) Me(ctx context.Context, callOpts ...CallOption) (*User, *Response, error) {
+	req, err := s.client.NewRequest("GET", "users/me", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user User
+	resp, err := s.client.Do(ctx, req, &user, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// Create creates a new user. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#create-a-user
+func (s *UsersService) Create(ctx context.Context, body *UserCreateRequest, callOpts ...CallOption) (*User, *Response, error) {
+	req, err := s.client.NewRequest("POST", "users", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user User
+	resp, err := s.client.Do(ctx, req, &user, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// Update updates a user. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#update-a-user
+func (s *UsersService) Update(ctx context.Context, userId int, body *UserUpdateRequest, callOpts ...CallOption) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d", userId)
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user User
+	resp, err := s.client.Do(ctx, req, &user, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// Delete deletes a user. callOpts customize this call only; see
+// CallOption.
+// https://help.getharvest.com/api-v2/users-api/users/users/#delete-a-user
+func (s *UsersService) Delete(ctx context.Context, userId int, callOpts ...CallOption) (*Response, error) {
+	u := fmt.Sprintf("users/%d", userId)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil, callOpts...)
+}
@@ -0,0 +1,120 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUsersService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"users": [{"id": 1782959}]}`)
+	})
+
+	users, _, err := client.Users.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("UsersService.List returned error: %v", err)
+	}
+
+	want := 1
+	if len(users) != want {
+		t.Errorf("UsersService.List returned %+v users, want %+v", len(users), want)
+	}
+}
+
+func TestUsersService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/1782959", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1782959, "first_name": "Jim"}`)
+	})
+
+	got, _, err := client.Users.Get(context.Background(), 1782959)
+	if err != nil {
+		t.Fatalf("UsersService.Get returned error: %v", err)
+	}
+
+	if want := "Jim"; got.FirstName != want {
+		t.Errorf("UsersService.Get FirstName = %v, want %v", got.FirstName, want)
+	}
+}
+
+func TestUsersService_Me(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1782959}`)
+	})
+
+	user, _, err := client.Users.Me(context.Background())
+	if err != nil {
+		t.Errorf("UsersService.Me returned error: %v", err)
+	}
+
+	if got, want := user.Id, 1782959; got != want {
+		t.Errorf("UsersService.Me returned id %v, want %v", got, want)
+	}
+}
+
+func TestUsersService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 1782959}`)
+	})
+
+	body := &UserCreateRequest{FirstName: "Jim", LastName: "Hendrix", Email: "jimi@example.com"}
+	got, _, err := client.Users.Create(context.Background(), body)
+	if err != nil {
+		t.Fatalf("UsersService.Create returned error: %v", err)
+	}
+
+	if want := 1782959; got.Id != want {
+		t.Errorf("UsersService.Create Id = %v, want %v", got.Id, want)
+	}
+}
+
+func TestUsersService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/1782959", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"id": 1782959, "first_name": "Renamed"}`)
+	})
+
+	name := "Renamed"
+	got, _, err := client.Users.Update(context.Background(), 1782959, &UserUpdateRequest{FirstName: &name})
+	if err != nil {
+		t.Fatalf("UsersService.Update returned error: %v", err)
+	}
+
+	if got.FirstName != name {
+		t.Errorf("UsersService.Update FirstName = %v, want %v", got.FirstName, name)
+	}
+}
+
+func TestUsersService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/1782959", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Users.Delete(context.Background(), 1782959); err != nil {
+		t.Errorf("UsersService.Delete returned error: %v", err)
+	}
+}